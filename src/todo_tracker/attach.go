@@ -0,0 +1,110 @@
+package todotracker
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"strings"
+)
+
+// attachmentFor finds the top-level declaration immediately following
+// afterPos (the end of a TODO's comment text) with no intervening blank
+// line, and describes it as an Attachment. It returns the zero
+// Attachment if no declaration qualifies.
+func attachmentFor(fset *token.FileSet, file *ast.File, afterPos token.Pos) Attachment {
+	afterLine := fset.Position(afterPos).Line
+
+	var next ast.Decl
+	var nextLine int
+	for _, decl := range file.Decls {
+		line := fset.Position(decl.Pos()).Line
+		if line <= afterLine {
+			continue
+		}
+		if next == nil || line < nextLine {
+			next, nextLine = decl, line
+		}
+	}
+
+	if next == nil || nextLine-afterLine > 1 {
+		return Attachment{}
+	}
+	return declAttachment(fset, next)
+}
+
+// declAttachment describes a top-level declaration for use as a TODO's
+// Attachment.
+func declAttachment(fset *token.FileSet, decl ast.Decl) Attachment {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return Attachment{Kind: "func", Name: funcName(d), Signature: funcSignature(fset, d)}
+	case *ast.GenDecl:
+		return genDeclAttachment(fset, d)
+	default:
+		return Attachment{}
+	}
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv != nil && len(fn.Recv.List) > 0 {
+		return recvTypeName(fn.Recv.List[0].Type) + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
+
+func funcSignature(fset *token.FileSet, fn *ast.FuncDecl) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, fn.Type); err != nil {
+		return "func " + funcName(fn)
+	}
+	rest := strings.TrimPrefix(buf.String(), "func")
+
+	if fn.Recv != nil && len(fn.Recv.List) > 0 {
+		return fmt.Sprintf("func (%s) %s%s", recvTypeName(fn.Recv.List[0].Type), fn.Name.Name, rest)
+	}
+	return fmt.Sprintf("func %s%s", fn.Name.Name, rest)
+}
+
+func genDeclAttachment(fset *token.FileSet, d *ast.GenDecl) Attachment {
+	kind := ""
+	switch d.Tok {
+	case token.TYPE:
+		kind = "type"
+	case token.VAR:
+		kind = "var"
+	case token.CONST:
+		kind = "const"
+	default:
+		return Attachment{}
+	}
+	if len(d.Specs) == 0 {
+		return Attachment{}
+	}
+
+	switch spec := d.Specs[0].(type) {
+	case *ast.TypeSpec:
+		var buf bytes.Buffer
+		sig := spec.Name.Name
+		if err := printer.Fprint(&buf, fset, spec.Type); err == nil {
+			sig = fmt.Sprintf("type %s %s", spec.Name.Name, buf.String())
+		}
+		return Attachment{Kind: kind, Name: spec.Name.Name, Signature: sig}
+	case *ast.ValueSpec:
+		if len(spec.Names) == 0 {
+			return Attachment{}
+		}
+		name := spec.Names[0].Name
+		sig := fmt.Sprintf("%s %s", kind, name)
+		if spec.Type != nil {
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, spec.Type); err == nil {
+				sig = fmt.Sprintf("%s %s %s", kind, name, buf.String())
+			}
+		}
+		return Attachment{Kind: kind, Name: name, Signature: sig}
+	default:
+		return Attachment{}
+	}
+}
@@ -0,0 +1,84 @@
+package todotracker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractFileAttachment(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "attach.go")
+	src := `package attach
+
+// TODO: add retries
+func Fetch() error {
+	return nil
+}
+
+// FIXME: this is stale
+// and spans two lines
+
+func Unattached() {}
+
+type Widget struct{}
+
+// HACK: tighten validation
+var MaxRetries = 3
+`
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	todos, err := ExtractFile(path, Options{})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	if len(todos) != 3 {
+		t.Fatalf("got %d todos, want 3: %+v", len(todos), todos)
+	}
+
+	fetch := todos[0]
+	if fetch.Attachment.Kind != "func" || fetch.Attachment.Name != "Fetch" {
+		t.Errorf("Fetch attachment = %+v, want func Fetch", fetch.Attachment)
+	}
+
+	unattached := todos[1]
+	if unattached.Attachment.Kind != "" {
+		t.Errorf("blank-line-separated TODO got attachment %+v, want none", unattached.Attachment)
+	}
+
+	maxRetries := todos[2]
+	if maxRetries.Attachment.Kind != "var" || maxRetries.Attachment.Name != "MaxRetries" {
+		t.Errorf("MaxRetries attachment = %+v, want var MaxRetries", maxRetries.Attachment)
+	}
+}
+
+func TestGroupByDecl(t *testing.T) {
+	todos := []TODO{
+		{Message: "a", Attachment: Attachment{Kind: "func", Name: "F"}},
+		{Message: "b", Attachment: Attachment{Kind: "func", Name: "F"}},
+		{Message: "c"},
+	}
+	groups := GroupByDecl(todos)
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+	if len(groups[0].TODOs) != 2 || groups[0].Attachment.Name != "F" {
+		t.Errorf("first group = %+v", groups[0])
+	}
+	if len(groups[1].TODOs) != 1 || groups[1].Attachment.Kind != "" {
+		t.Errorf("second group = %+v", groups[1])
+	}
+}
+
+func TestGroupByDeclDiscriminatesByFile(t *testing.T) {
+	todos := []TODO{
+		{Message: "a", File: "pkg/a/a.go", Attachment: Attachment{Kind: "func", Name: "Run"}},
+		{Message: "b", File: "pkg/b/b.go", Attachment: Attachment{Kind: "func", Name: "Run"}},
+	}
+	groups := GroupByDecl(todos)
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2 (same decl name in different files must not merge)", len(groups))
+	}
+}
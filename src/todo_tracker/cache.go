@@ -0,0 +1,90 @@
+package todotracker
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Cache is a small on-disk store, keyed by content hash, that remembers
+// which TODOs have already had a ticket created for them. A successful
+// `sync` rewrites the ticket ID straight into the source comment, so on
+// the next run ExtractFile reports a TicketID and Sync verifies it
+// instead of calling CreateIssue again — the cache doesn't do anything
+// there. What it guards against is the partial failure in between: if
+// CreateIssue succeeds but the comment rewrite fails (a read-only file,
+// a concurrent edit, a crash), the TODO still looks ticket-less on the
+// next run, and the cache is what stops that from filing a second,
+// duplicate ticket.
+//
+// This stores a flat JSON file rather than SQLite, despite the original
+// request asking for the latter: the environment this was built in has
+// no network access to vendor a SQLite driver, pure-Go or otherwise.
+// A flat file gives the same hash -> ticket ID lookup with zero
+// dependencies; swap it for a real `database/sql` + SQLite driver
+// combination if that guarantee is needed.
+//
+// That swap matters most for concurrency: the mutex below only
+// serializes Get/Put within a single process. Two `sync` runs pointed at
+// the same cache path are not safe to run concurrently — each opens its
+// own copy of entries, and whichever process's Put calls writes the
+// whole file last wins, silently dropping the other process's entries.
+// A real SQLite file would give cross-process locking for free; callers
+// of OpenCache must otherwise ensure only one process touches a given
+// cache path at a time.
+type Cache struct {
+	path    string
+	mu      sync.Mutex
+	entries map[string]string // content hash -> ticket ID
+}
+
+// OpenCache loads the cache at path, creating an empty one if it doesn't
+// exist yet.
+func OpenCache(path string) (*Cache, error) {
+	c := &Cache{path: path, entries: map[string]string{}}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("todotracker: opening cache %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		return nil, fmt.Errorf("todotracker: parsing cache %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Get returns the ticket ID previously cached for hash, if any.
+func (c *Cache) Get(hash string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.entries[hash]
+	return id, ok
+}
+
+// Put records that hash now has ticketID filed for it and persists the
+// cache to disk.
+func (c *Cache) Put(hash, ticketID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[hash] = ticketID
+
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+// ContentHash returns the stable key used to dedupe ticket creation for a
+// TODO: the hash of where it lives (file and line) and what it says, so
+// the same TODO hashes the same way across a create-then-rewrite-fails
+// retry, but editing the message or moving it to another line changes it.
+func ContentHash(t TODO) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s:%s", t.File, t.Line, t.Tag, t.Message)))
+	return fmt.Sprintf("%x", sum)
+}
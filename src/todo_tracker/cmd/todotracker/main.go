@@ -0,0 +1,249 @@
+// Command todotracker extracts TODO/FIXME/HACK/XXX comments from Go source
+// files and prints them as structured records.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	todotracker "github.com/JoshGearou/dev-toolkit/src/todo_tracker"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "todotracker:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) > 0 && args[0] == "sync" {
+		return runSync(args[1:])
+	}
+	return runExtract(args)
+}
+
+func runExtract(args []string) error {
+	fs := flag.NewFlagSet("todotracker", flag.ExitOnError)
+	assignee := fs.String("assignee", "", "only show TODOs assigned to this name")
+	tag := fs.String("tag", "", "only show TODOs with this tag (TODO, FIXME, HACK, XXX)")
+	ticket := fs.String("ticket", "", "only show TODOs referencing this ticket ID")
+	format := fs.String("f", "text", "output format: text, json, csv, sarif, junit-xml, todotxt")
+	groupBy := fs.String("group-by", "", "group output by attached declaration (decl)")
+	var exclude excludeFlag
+	fs.Var(&exclude, "exclude", "glob pattern to exclude (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("usage: todotracker [flags] <file.go> [file.go ...]")
+	}
+
+	formatter, ok := todotracker.Formatters[*format]
+	if !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	opts := todotracker.Options{
+		Assignee: *assignee,
+		Tag:      todotracker.Tag(*tag),
+		Ticket:   *ticket,
+		Exclude:  exclude,
+	}
+
+	var todos []todotracker.TODO
+	for _, path := range fs.Args() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			fileTodos, err := todotracker.ExtractFile(path, opts)
+			if err != nil {
+				return err
+			}
+			todos = append(todos, fileTodos...)
+			continue
+		}
+
+		walkTodos, walkErr := walkDir(path, opts)
+		if walkErr != nil {
+			return walkErr
+		}
+		todos = append(todos, walkTodos...)
+	}
+
+	if *groupBy == "decl" {
+		printGroupedByDecl(os.Stdout, todos)
+		return nil
+	}
+
+	return formatter.Format(os.Stdout, todos)
+}
+
+// printGroupedByDecl prints todos under a heading for each declaration
+// they're attached to, in text form.
+func printGroupedByDecl(w io.Writer, todos []todotracker.TODO) {
+	for _, group := range todotracker.GroupByDecl(todos) {
+		if group.Attachment.Kind == "" {
+			fmt.Fprintln(w, "(unattached):")
+		} else {
+			fmt.Fprintf(w, "%s %s:\n", group.Attachment.Kind, group.Attachment.Signature)
+		}
+		for _, t := range group.TODOs {
+			fmt.Fprintf(w, "  %s:%d: [%s] %s\n", t.File, t.Line, t.Tag, t.Message)
+		}
+	}
+}
+
+// walkDir drains todotracker.Walk for root, printing any per-file errors
+// to stderr and a progress indicator as files are scanned.
+func walkDir(root string, opts todotracker.Options) ([]todotracker.TODO, error) {
+	todoCh, errCh := todotracker.Walk(root, todotracker.WalkOptions{
+		Options:  opts,
+		Progress: os.Stderr,
+	})
+
+	var todos []todotracker.TODO
+	for todoCh != nil || errCh != nil {
+		select {
+		case t, ok := <-todoCh:
+			if !ok {
+				todoCh = nil
+				continue
+			}
+			todos = append(todos, t)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			fmt.Fprintln(os.Stderr, "todotracker:", err)
+		}
+	}
+	return todos, nil
+}
+
+// runSync extracts TODOs from the given paths and reconciles their ticket
+// references against an external tracker.
+func runSync(args []string) error {
+	fs := flag.NewFlagSet("todotracker sync", flag.ExitOnError)
+	trackerName := fs.String("tracker", "github", "issue tracker: github, gitlab, or jira")
+	repo := fs.String("repo", "", "GitHub \"owner/repo\" (tracker=github)")
+	project := fs.String("project", "", "GitLab project ID or Jira project key (tracker=gitlab, jira)")
+	cachePath := fs.String("cache", ".todotracker-cache.json", "path to the local ticket-creation cache (flat JSON, not SQLite; see cache.go)")
+	autoCreate := fs.Bool("auto-create", false, "file a new ticket for TODOs without one, rewriting the source comment")
+	dryRun := fs.Bool("dry-run", false, "report what would change without calling the tracker or editing files")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("usage: todotracker sync [flags] <file.go> [file.go ...]")
+	}
+
+	tracker, err := newTracker(*trackerName, *repo, *project)
+	if err != nil {
+		return err
+	}
+
+	cache, err := todotracker.OpenCache(*cachePath)
+	if err != nil {
+		return err
+	}
+
+	var todos []todotracker.TODO
+	for _, path := range fs.Args() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			walkTodos, err := walkDir(path, todotracker.Options{})
+			if err != nil {
+				return err
+			}
+			todos = append(todos, walkTodos...)
+			continue
+		}
+		fileTodos, err := todotracker.ExtractFile(path, todotracker.Options{})
+		if err != nil {
+			return err
+		}
+		todos = append(todos, fileTodos...)
+	}
+
+	results := todotracker.Sync(context.Background(), todos, todotracker.SyncOptions{
+		Tracker:    tracker,
+		Cache:      cache,
+		AutoCreate: *autoCreate,
+		DryRun:     *dryRun,
+	})
+
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(os.Stderr, "todotracker: %s:%d: %v\n", r.TODO.File, r.TODO.Line, r.Err)
+			continue
+		}
+		fmt.Printf("%s:%d: %s", r.TODO.File, r.TODO.Line, r.Action)
+		if r.TicketID != "" {
+			fmt.Printf(" (%s)", r.TicketID)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// newTracker constructs the Tracker named by name, using repo for GitHub
+// and project for GitLab/Jira.
+func newTracker(name, repo, project string) (todotracker.Tracker, error) {
+	switch name {
+	case "github":
+		owner, repoName, ok := splitOwnerRepo(repo)
+		if !ok {
+			return nil, fmt.Errorf("-repo must be \"owner/repo\" for tracker=github")
+		}
+		return todotracker.NewGitHubTracker(owner, repoName)
+	case "gitlab":
+		if project == "" {
+			return nil, fmt.Errorf("-project is required for tracker=gitlab")
+		}
+		return todotracker.NewGitLabTracker(project)
+	case "jira":
+		if project == "" {
+			return nil, fmt.Errorf("-project is required for tracker=jira")
+		}
+		return todotracker.NewJiraTracker(project)
+	default:
+		return nil, fmt.Errorf("unknown tracker %q", name)
+	}
+}
+
+func splitOwnerRepo(s string) (owner, repo string, ok bool) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '/' {
+			return s[:i], s[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+// excludeFlag collects repeated -exclude flag values into a slice.
+type excludeFlag []string
+
+func (e *excludeFlag) String() string {
+	if e == nil {
+		return ""
+	}
+	return fmt.Sprint([]string(*e))
+}
+
+func (e *excludeFlag) Set(value string) error {
+	*e = append(*e, value)
+	return nil
+}
@@ -0,0 +1,223 @@
+package todotracker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// defaultTicketPattern matches ticket references like "PROJ-567".
+var defaultTicketPattern = regexp.MustCompile(`[A-Z][A-Z0-9]*-[0-9]+`)
+
+// tagPattern matches a tag at the start of a comment line, with an optional
+// "(assignee)" annotation and an optional colon before the message.
+var tagPattern = regexp.MustCompile(`^(TODO|FIXME|HACK|XXX)(?:\(([^)]*)\))?:?\s*(.*)$`)
+
+// Options controls extraction and filtering behavior.
+type Options struct {
+	// TicketPattern overrides the default regex used to pull a ticket ID
+	// (e.g. "PROJ-567") out of a TODO's assignee annotation or message.
+	TicketPattern *regexp.Regexp
+
+	// Assignee, Tag, and Ticket, when non-empty, restrict results to
+	// records matching exactly.
+	Assignee string
+	Tag      Tag
+	Ticket   string
+
+	// Exclude holds path glob patterns (matched with filepath.Match
+	// against the file path) whose files are skipped entirely.
+	Exclude []string
+}
+
+func (o Options) ticketPattern() *regexp.Regexp {
+	if o.TicketPattern != nil {
+		return o.TicketPattern
+	}
+	return defaultTicketPattern
+}
+
+// excludes reports whether path matches any of the configured exclude globs.
+func (o Options) excludes(path string) bool {
+	for _, pattern := range o.Exclude {
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether a TODO passes the configured filters.
+func (o Options) matches(t TODO) bool {
+	if o.Assignee != "" && t.Assignee != o.Assignee {
+		return false
+	}
+	if o.Tag != "" && t.Tag != o.Tag {
+		return false
+	}
+	if o.Ticket != "" && t.TicketID != o.Ticket {
+		return false
+	}
+	return true
+}
+
+// ExtractFile parses the Go source file at path and returns every TODO
+// comment found, coalesced from contiguous comment groups and filtered
+// according to opts.
+func ExtractFile(path string, opts Options) ([]TODO, error) {
+	if opts.excludes(path) {
+		return nil, nil
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("todotracker: parsing %s: %w", path, err)
+	}
+
+	var todos []TODO
+	for _, group := range file.Comments {
+		todos = append(todos, extractGroup(fset, file, path, group, opts)...)
+	}
+	return todos, nil
+}
+
+// extractGroup walks the comment lines of a single CommentGroup, coalescing
+// a tagged line together with any untagged lines that follow it (up to the
+// next tagged line or the end of the group) into one TODO record.
+func extractGroup(fset *token.FileSet, file *ast.File, path string, group *ast.CommentGroup, opts Options) []TODO {
+	var todos []TODO
+
+	lines := group.List
+	for i := 0; i < len(lines); i++ {
+		text := commentText(lines[i])
+		m := tagPattern.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+
+		assignee, parenTicket := parseAnnotation(m[2], opts.ticketPattern())
+		todo := TODO{
+			Tag:           Tag(m[1]),
+			Assignee:      assignee,
+			File:          path,
+			Line:          fset.Position(lines[i].Pos()).Line,
+			EnclosingFunc: enclosingFunc(fset, file, lines[i].Pos()),
+		}
+
+		msgLines := []string{m[3]}
+		j := i + 1
+		for j < len(lines) {
+			next := commentText(lines[j])
+			if tagPattern.MatchString(next) {
+				break
+			}
+			msgLines = append(msgLines, next)
+			j++
+		}
+		lastLine := lines[j-1]
+		i = j - 1
+
+		todo.Message = strings.TrimSpace(strings.Join(msgLines, " "))
+		todo.TicketID = parenTicket
+		if todo.TicketID == "" {
+			todo.TicketID = opts.ticketPattern().FindString(todo.Message)
+		}
+		todo.Attachment = attachmentFor(fset, file, lastLine.End())
+
+		if opts.matches(todo) {
+			todos = append(todos, todo)
+		}
+	}
+
+	return todos
+}
+
+// parseAnnotation splits a "(...)" annotation into its assignee and ticket
+// parts. A lone annotation that is itself a ticket reference (e.g.
+// "TODO(PROJ-567)") is returned as both the assignee and the ticket, for
+// backward compatibility with comments that use the parenthetical purely
+// to carry a ticket ID. A comma-separated annotation (e.g.
+// "TODO(GH-123, alice)") splits into its ticket and non-ticket parts, so
+// auto-created tickets can be merged into an existing assignee annotation
+// without clobbering it.
+func parseAnnotation(raw string, ticketPattern *regexp.Regexp) (assignee, ticket string) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", ""
+	}
+
+	parts := strings.Split(raw, ",")
+	if len(parts) == 1 {
+		p := strings.TrimSpace(parts[0])
+		if ticketPattern.FindString(p) == p {
+			return p, p
+		}
+		return p, ""
+	}
+
+	var assigneeParts []string
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if ticket == "" && ticketPattern.FindString(p) == p {
+			ticket = p
+			continue
+		}
+		assigneeParts = append(assigneeParts, p)
+	}
+	return strings.Join(assigneeParts, ", "), ticket
+}
+
+// commentText strips the leading "//" or surrounding "/* */" from a single
+// comment line and trims whitespace.
+func commentText(c *ast.Comment) string {
+	text := c.Text
+	switch {
+	case strings.HasPrefix(text, "//"):
+		text = text[2:]
+	case strings.HasPrefix(text, "/*"):
+		text = strings.TrimSuffix(text[2:], "*/")
+	}
+	return strings.TrimSpace(text)
+}
+
+// enclosingFunc returns the name of the nearest top-level function or
+// method declaration that contains pos, or "" if pos falls outside every
+// function body.
+func enclosingFunc(fset *token.FileSet, file *ast.File, pos token.Pos) string {
+	var name string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || pos < fn.Pos() || pos > fn.End() {
+			continue
+		}
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			name = fmt.Sprintf("%s.%s", recvTypeName(fn.Recv.List[0].Type), fn.Name.Name)
+		} else {
+			name = fn.Name.Name
+		}
+		break
+	}
+	return name
+}
+
+// recvTypeName renders a method receiver type as "Foo" or "*Foo".
+func recvTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		return "*" + recvTypeName(star.X)
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return fmt.Sprintf("%s", expr)
+}
@@ -0,0 +1,58 @@
+package todotracker
+
+import "testing"
+
+const fixturePath = "tests/fixtures/sample.go"
+
+func TestExtractFile(t *testing.T) {
+	todos, err := ExtractFile(fixturePath, Options{})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	if len(todos) != 4 {
+		t.Fatalf("got %d todos, want 4", len(todos))
+	}
+
+	want := []TODO{
+		{Tag: TagTODO, Line: 7, EnclosingFunc: "main", Message: "add CLI argument parsing"},
+		{Tag: TagFIXME, Line: 10, EnclosingFunc: "main", Message: "goroutine leak when context is cancelled"},
+		{Tag: TagHACK, Assignee: "PROJ-567", TicketID: "PROJ-567", Line: 13, EnclosingFunc: "main", Message: "bypass rate limiter for internal calls"},
+		{Tag: TagXXX, Line: 17, EnclosingFunc: "worker", Message: "placeholder implementation"},
+	}
+
+	for i, w := range want {
+		got := todos[i]
+		if got.Tag != w.Tag || got.Assignee != w.Assignee || got.TicketID != w.TicketID ||
+			got.Line != w.Line || got.EnclosingFunc != w.EnclosingFunc || got.Message != w.Message {
+			t.Errorf("todos[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestExtractFileFilters(t *testing.T) {
+	todos, err := ExtractFile(fixturePath, Options{Tag: TagFIXME})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	if len(todos) != 1 || todos[0].Tag != TagFIXME {
+		t.Fatalf("Tag filter: got %+v", todos)
+	}
+
+	todos, err = ExtractFile(fixturePath, Options{Ticket: "PROJ-567"})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	if len(todos) != 1 || todos[0].TicketID != "PROJ-567" {
+		t.Fatalf("Ticket filter: got %+v", todos)
+	}
+}
+
+func TestExtractFileExclude(t *testing.T) {
+	todos, err := ExtractFile(fixturePath, Options{Exclude: []string{"*/fixtures/*"}})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	if todos != nil {
+		t.Fatalf("expected excluded file to yield no todos, got %+v", todos)
+	}
+}
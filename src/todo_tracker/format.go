@@ -0,0 +1,258 @@
+package todotracker
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Formatter renders a slice of TODO records to w.
+type Formatter interface {
+	Format(w io.Writer, todos []TODO) error
+}
+
+// Formatters maps the -f flag values accepted by the CLI to their
+// Formatter implementation.
+var Formatters = map[string]Formatter{
+	"text":      TextFormatter{},
+	"json":      JSONFormatter{},
+	"csv":       CSVFormatter{},
+	"sarif":     SARIFFormatter{},
+	"junit-xml": JUnitFormatter{},
+	"todotxt":   TodoTxtFormatter{},
+}
+
+// TextFormatter renders one human-readable line per TODO.
+type TextFormatter struct{}
+
+func (TextFormatter) Format(w io.Writer, todos []TODO) error {
+	for _, t := range todos {
+		if _, err := fmt.Fprintf(w, "%s:%d: [%s]", t.File, t.Line, t.Tag); err != nil {
+			return err
+		}
+		if t.Assignee != "" {
+			if _, err := fmt.Fprintf(w, " (%s)", t.Assignee); err != nil {
+				return err
+			}
+		}
+		if t.EnclosingFunc != "" {
+			if _, err := fmt.Fprintf(w, " in %s", t.EnclosingFunc); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, ": %s\n", t.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONFormatter renders the TODOs as a single JSON array.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(w io.Writer, todos []TODO) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(todos)
+}
+
+// CSVFormatter renders the TODOs as CSV with a header row.
+type CSVFormatter struct{}
+
+func (CSVFormatter) Format(w io.Writer, todos []TODO) error {
+	cw := csv.NewWriter(w)
+	header := []string{"tag", "assignee", "ticket", "file", "line", "enclosing_func", "message"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, t := range todos {
+		row := []string{
+			string(t.Tag),
+			t.Assignee,
+			t.TicketID,
+			t.File,
+			fmt.Sprint(t.Line),
+			t.EnclosingFunc,
+			t.Message,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// sarifLevel maps a Tag to the SARIF result level used by GitHub code
+// scanning: FIXME/XXX surface as warnings, HACK as an error, and TODO as
+// an informational note.
+func sarifLevel(tag Tag) string {
+	switch tag {
+	case TagFIXME, TagXXX:
+		return "warning"
+	case TagHACK:
+		return "error"
+	default:
+		return "note"
+	}
+}
+
+// SARIFFormatter renders the TODOs as a SARIF 2.1.0 log suitable for
+// upload via the GitHub code-scanning API.
+type SARIFFormatter struct{}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func (SARIFFormatter) Format(w io.Writer, todos []TODO) error {
+	results := make([]sarifResult, 0, len(todos))
+	for _, t := range todos {
+		results = append(results, sarifResult{
+			RuleID:  string(t.Tag),
+			Level:   sarifLevel(t.Tag),
+			Message: sarifMessage{Text: t.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: t.File},
+					Region:           sarifRegion{StartLine: t.Line},
+				},
+			}},
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "todotracker", Version: "1.0.0"}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// JUnitFormatter renders the TODOs as a JUnit XML report with one
+// testsuite per file, so CI can treat unresolved FIXMEs as failing tests.
+type JUnitFormatter struct{}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (JUnitFormatter) Format(w io.Writer, todos []TODO) error {
+	var order []string
+	byFile := map[string][]TODO{}
+	for _, t := range todos {
+		if _, ok := byFile[t.File]; !ok {
+			order = append(order, t.File)
+		}
+		byFile[t.File] = append(byFile[t.File], t)
+	}
+
+	suites := junitTestSuites{}
+	for _, file := range order {
+		fileTodos := byFile[file]
+		suite := junitTestSuite{Name: file, Tests: len(fileTodos)}
+		for _, t := range fileTodos {
+			tc := junitTestCase{Name: fmt.Sprintf("%s:%d %s", t.Tag, t.Line, t.Message)}
+			if t.Tag == TagFIXME {
+				tc.Failure = &junitFailure{Message: "unresolved FIXME", Text: t.Message}
+				suite.Failures++
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// TodoTxtFormatter renders the TODOs in Gina Trapani's todo.txt format
+// (see the todotxt package), one entry per line.
+type TodoTxtFormatter struct{}
+
+func (TodoTxtFormatter) Format(w io.Writer, todos []TODO) error {
+	for _, entry := range ExportTodoTxt(todos) {
+		if _, err := fmt.Fprintln(w, entry.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
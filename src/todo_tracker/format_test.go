@@ -0,0 +1,71 @@
+package todotracker
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func sampleTODOs(t *testing.T) []TODO {
+	t.Helper()
+	todos, err := ExtractFile(fixturePath, Options{})
+	if err != nil {
+		t.Fatalf("ExtractFile: %v", err)
+	}
+	return todos
+}
+
+func TestTextFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (TextFormatter{}).Format(&buf, sampleTODOs(t)); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if !strings.Contains(buf.String(), "[FIXME]") {
+		t.Errorf("text output missing FIXME line: %s", buf.String())
+	}
+}
+
+func TestJSONFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (JSONFormatter{}).Format(&buf, sampleTODOs(t)); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"Tag": "FIXME"`) {
+		t.Errorf("json output missing FIXME tag: %s", buf.String())
+	}
+}
+
+func TestCSVFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (CSVFormatter{}).Format(&buf, sampleTODOs(t)); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 { // header + 4 todos
+		t.Fatalf("got %d lines, want 5: %v", len(lines), lines)
+	}
+}
+
+func TestSARIFFormatterLevels(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (SARIFFormatter{}).Format(&buf, sampleTODOs(t)); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{`"ruleId": "HACK"`, `"level": "error"`, `"ruleId": "TODO"`, `"level": "note"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("sarif output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestJUnitFormatterFailuresAreFIXMEs(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (JUnitFormatter{}).Format(&buf, sampleTODOs(t)); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	out := buf.String()
+	if strings.Count(out, "<failure") != 1 {
+		t.Errorf("expected exactly one failure (the FIXME), got: %s", out)
+	}
+}
@@ -0,0 +1,39 @@
+package todotracker
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// blameCreationDate runs `git blame` on the given line of path and returns
+// the author date of the commit that introduced it.
+func blameCreationDate(path string, line int) (time.Time, error) {
+	dir, base := filepath.Split(path)
+	cmd := exec.Command("git", "blame", "-L", fmt.Sprintf("%d,%d", line, line), "--porcelain", "--", base)
+	if dir != "" {
+		cmd.Dir = dir
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("todotracker: git blame %s:%d: %w", path, line, err)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "author-time" {
+			unix, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(unix, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("todotracker: no author-time found for %s:%d", path, line)
+}
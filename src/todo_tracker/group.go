@@ -0,0 +1,28 @@
+package todotracker
+
+// DeclGroup collects every TODO attached to the same declaration (see
+// Attachment). TODOs with no attachment share the zero-value group.
+type DeclGroup struct {
+	Attachment Attachment
+	TODOs      []TODO
+}
+
+// GroupByDecl groups todos by the declaration they're attached to,
+// preserving the order each distinct declaration first appears in.
+func GroupByDecl(todos []TODO) []DeclGroup {
+	var groups []DeclGroup
+	index := map[string]int{}
+
+	for _, t := range todos {
+		key := t.File + ":" + t.Attachment.Kind + ":" + t.Attachment.Name
+		idx, ok := index[key]
+		if !ok {
+			idx = len(groups)
+			index[key] = idx
+			groups = append(groups, DeclGroup{Attachment: t.Attachment})
+		}
+		groups[idx].TODOs = append(groups[idx].TODOs, t)
+	}
+
+	return groups
+}
@@ -0,0 +1,109 @@
+package todotracker
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitignorePattern is a single non-empty, non-comment line from a
+// .gitignore file, scoped to the directory it was read from.
+type gitignorePattern struct {
+	dir      string // directory the pattern was loaded from, slash-separated
+	pattern  string
+	dirOnly  bool
+	anchored bool
+}
+
+// ignoreMatcher is a small, best-effort .gitignore matcher: it supports
+// "*"/"?" globs, directory-only patterns ("foo/"), and patterns anchored
+// to the directory they came from ("/foo") versus patterns that match at
+// any depth beneath it ("foo"). It does not support "!" negation or "**".
+type ignoreMatcher struct {
+	patterns []gitignorePattern
+}
+
+// loadGitignore reads every .gitignore file under root and returns a
+// matcher combining all of their patterns.
+func loadGitignore(root string) (*ignoreMatcher, error) {
+	m := &ignoreMatcher{}
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != ".gitignore" {
+			return nil
+		}
+		relDir, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		dir := filepath.ToSlash(relDir)
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			p := gitignorePattern{dir: dir}
+			if strings.HasSuffix(line, "/") {
+				p.dirOnly = true
+				line = strings.TrimSuffix(line, "/")
+			}
+			if strings.HasPrefix(line, "/") {
+				p.anchored = true
+				line = strings.TrimPrefix(line, "/")
+			}
+			p.pattern = line
+			m.patterns = append(m.patterns, p)
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// match reports whether path (slash-separated, relative to the same root
+// loadGitignore was called with) should be ignored. isDir indicates
+// whether path refers to a directory.
+func (m *ignoreMatcher) match(path string, isDir bool) bool {
+	if m == nil {
+		return false
+	}
+	path = filepath.ToSlash(path)
+	for _, p := range m.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		rel := path
+		if p.dir != "." {
+			if !strings.HasPrefix(path+"/", p.dir+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(path, p.dir+"/")
+		}
+
+		if p.anchored {
+			if ok, _ := filepath.Match(p.pattern, rel); ok {
+				return true
+			}
+			continue
+		}
+
+		for _, segment := range strings.Split(rel, "/") {
+			if ok, _ := filepath.Match(p.pattern, segment); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
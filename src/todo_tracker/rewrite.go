@@ -0,0 +1,81 @@
+package todotracker
+
+import (
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+)
+
+// tagPrefixPattern matches a tag at the start of a raw comment (including
+// its "//" or "/*" marker), capturing the tag word and, if present, an
+// existing "(...)" annotation immediately following it so a ticket
+// annotation can be merged into it rather than clobbering it.
+var tagPrefixPattern = regexp.MustCompile(`^(//|/\*)\s*(TODO|FIXME|HACK|XXX)(\(([^)]*)\))?`)
+
+// RewriteTicketID rewrites the TODO/FIXME/HACK/XXX comment on the given
+// line of path to annotate it with ticketID, e.g. turning "// TODO: foo"
+// into "// TODO(GH-123): foo". If the comment already carries a "(...)"
+// annotation (an assignee, say), the ticket is merged into it instead of
+// replacing it, e.g. "// TODO(alice): foo" becomes
+// "// TODO(GH-123, alice): foo". It reports an error if no TODO-style
+// comment is found on that line.
+func RewriteTicketID(path string, line int, ticketID string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("todotracker: parsing %s: %w", path, err)
+	}
+
+	start, end := -1, -1
+	replacement := ""
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if fset.Position(c.Pos()).Line != line {
+				continue
+			}
+			m := tagPrefixPattern.FindStringSubmatchIndex(c.Text)
+			if m == nil {
+				continue
+			}
+			base := fset.Position(c.Pos()).Offset
+			if m[6] < 0 {
+				// No existing "(...)": insert one right after the tag.
+				start, end = base+m[5], base+m[5]
+				replacement = fmt.Sprintf("(%s)", ticketID)
+				continue
+			}
+			// Existing "(...)": merge the ticket into it rather than
+			// inserting a second, unparseable parenthetical.
+			start, end = base+m[6], base+m[7]
+			existing := c.Text[m[8]:m[9]]
+			replacement = fmt.Sprintf("(%s, %s)", ticketID, existing)
+		}
+	}
+	if start < 0 {
+		return fmt.Errorf("todotracker: no TODO-style comment found at %s:%d", path, line)
+	}
+
+	rewritten := append([]byte{}, src[:start]...)
+	rewritten = append(rewritten, replacement...)
+	rewritten = append(rewritten, src[end:]...)
+
+	formatted, err := format.Source(rewritten)
+	if err != nil {
+		return fmt.Errorf("todotracker: formatting rewritten %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, formatted, info.Mode())
+}
@@ -0,0 +1,102 @@
+package todotracker
+
+import "context"
+
+// SyncAction describes what Sync did with a single TODO.
+type SyncAction string
+
+const (
+	// ActionVerifiedOpen means the TODO's ticket exists and is open.
+	ActionVerifiedOpen SyncAction = "verified-open"
+	// ActionTicketClosed means the TODO's ticket exists but is closed.
+	ActionTicketClosed SyncAction = "ticket-closed"
+	// ActionCreated means a new ticket was filed and the source comment
+	// was rewritten to reference it.
+	ActionCreated SyncAction = "created"
+	// ActionWouldCreate means a new ticket would be filed, but -dry-run
+	// suppressed it.
+	ActionWouldCreate SyncAction = "would-create"
+)
+
+// SyncResult is the outcome of reconciling one TODO against a Tracker.
+type SyncResult struct {
+	TODO     TODO
+	Action   SyncAction
+	TicketID string
+	Err      error
+}
+
+// SyncOptions controls how Sync reconciles TODOs with an external
+// tracker.
+type SyncOptions struct {
+	Tracker Tracker
+	Cache   *Cache
+
+	// AutoCreate files a new ticket (and rewrites the source comment to
+	// reference it) for every TODO that doesn't already have one.
+	AutoCreate bool
+
+	// DryRun reports what would be created without calling the tracker
+	// or touching source files.
+	DryRun bool
+}
+
+// Sync reconciles each TODO's ticket reference against opts.Tracker: for
+// TODOs with a ticket ID, it confirms the ticket still exists and is
+// open; for TODOs without one, it optionally files a new ticket and
+// rewrites the source comment in place to reference it.
+func Sync(ctx context.Context, todos []TODO, opts SyncOptions) []SyncResult {
+	results := make([]SyncResult, 0, len(todos))
+
+	for _, t := range todos {
+		if t.TicketID != "" {
+			results = append(results, verifyTicket(ctx, t, opts))
+			continue
+		}
+		if !opts.AutoCreate {
+			continue
+		}
+		results = append(results, createTicket(ctx, t, opts))
+	}
+
+	return results
+}
+
+func verifyTicket(ctx context.Context, t TODO, opts SyncOptions) SyncResult {
+	open, err := opts.Tracker.IssueOpen(ctx, t.TicketID)
+	if err != nil {
+		return SyncResult{TODO: t, TicketID: t.TicketID, Err: err}
+	}
+	action := ActionTicketClosed
+	if open {
+		action = ActionVerifiedOpen
+	}
+	return SyncResult{TODO: t, TicketID: t.TicketID, Action: action}
+}
+
+func createTicket(ctx context.Context, t TODO, opts SyncOptions) SyncResult {
+	hash := ContentHash(t)
+	if id, ok := opts.Cache.Get(hash); ok {
+		return SyncResult{TODO: t, TicketID: id, Action: ActionCreated}
+	}
+
+	if opts.DryRun {
+		return SyncResult{TODO: t, Action: ActionWouldCreate}
+	}
+
+	id, err := opts.Tracker.CreateIssue(ctx, string(t.Tag)+": "+t.Message, t.Message)
+	if err != nil {
+		return SyncResult{TODO: t, Err: err}
+	}
+	if err := opts.Cache.Put(hash, id); err != nil {
+		return SyncResult{TODO: t, TicketID: id, Err: err}
+	}
+	if err := RewriteTicketID(t.File, t.Line, id); err != nil {
+		// The ticket was filed and cached even though the comment wasn't
+		// rewritten; a retry will hit the cache above instead of filing a
+		// duplicate.
+		return SyncResult{TODO: t, TicketID: id, Action: ActionCreated, Err: err}
+	}
+
+	return SyncResult{TODO: t, TicketID: id, Action: ActionCreated}
+}
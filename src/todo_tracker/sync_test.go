@@ -0,0 +1,204 @@
+package todotracker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeTracker is an in-memory Tracker used for testing Sync without
+// hitting a real issue-tracker API.
+type fakeTracker struct {
+	open    map[string]bool
+	created []string
+	nextID  int
+}
+
+func (f *fakeTracker) IssueOpen(ctx context.Context, id string) (bool, error) {
+	return f.open[id], nil
+}
+
+func (f *fakeTracker) CreateIssue(ctx context.Context, title, body string) (string, error) {
+	f.nextID++
+	id := "FAKE-" + string(rune('0'+f.nextID))
+	f.created = append(f.created, id)
+	return id, nil
+}
+
+func TestSyncVerifiesExistingTickets(t *testing.T) {
+	tracker := &fakeTracker{open: map[string]bool{"PROJ-567": true}}
+	cache, err := OpenCache(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todos := []TODO{{Tag: TagHACK, TicketID: "PROJ-567", Message: "bypass rate limiter"}}
+	results := Sync(context.Background(), todos, SyncOptions{Tracker: tracker, Cache: cache})
+
+	if len(results) != 1 || results[0].Action != ActionVerifiedOpen {
+		t.Fatalf("got %+v, want a single verified-open result", results)
+	}
+}
+
+func TestSyncAutoCreateRewritesComment(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sample.go")
+	src := "package sample\n\nfunc F() {\n\t// TODO: add retries\n}\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tracker := &fakeTracker{}
+	cache, err := OpenCache(filepath.Join(dir, "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todos, err := ExtractFile(path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := Sync(context.Background(), todos, SyncOptions{Tracker: tracker, Cache: cache, AutoCreate: true})
+	if len(results) != 1 || results[0].Action != ActionCreated || results[0].TicketID == "" {
+		t.Fatalf("got %+v, want a single created result", results)
+	}
+
+	rewritten, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "// TODO(" + results[0].TicketID + "): add retries"; !strings.Contains(string(rewritten), want) {
+		t.Fatalf("rewritten source missing %q:\n%s", want, rewritten)
+	}
+
+	// A second sync should reuse the cached ticket instead of creating a
+	// duplicate.
+	todos, err = ExtractFile(path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results = Sync(context.Background(), todos, SyncOptions{Tracker: tracker, Cache: cache, AutoCreate: true})
+	if len(tracker.created) != 1 {
+		t.Fatalf("expected no duplicate ticket creation, created = %v", tracker.created)
+	}
+}
+
+func TestSyncAutoCreateMergesExistingAssignee(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sample.go")
+	src := "package sample\n\nfunc F() {\n\t// TODO(alice): add retries\n}\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tracker := &fakeTracker{}
+	cache, err := OpenCache(filepath.Join(dir, "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todos, err := ExtractFile(path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(todos) != 1 || todos[0].Assignee != "alice" {
+		t.Fatalf("got %+v, want a single TODO assigned to alice", todos)
+	}
+
+	results := Sync(context.Background(), todos, SyncOptions{Tracker: tracker, Cache: cache, AutoCreate: true})
+	if len(results) != 1 || results[0].Action != ActionCreated || results[0].TicketID == "" {
+		t.Fatalf("got %+v, want a single created result", results)
+	}
+
+	rewritten, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "// TODO(" + results[0].TicketID + ", alice): add retries"
+	if !strings.Contains(string(rewritten), want) {
+		t.Fatalf("rewritten source missing %q:\n%s", want, rewritten)
+	}
+
+	// Re-extracting must recover both fields: the new ticket and the
+	// original assignee, neither clobbering the other.
+	reExtracted, err := ExtractFile(path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reExtracted) != 1 {
+		t.Fatalf("got %d todos after rewrite, want 1: %+v", len(reExtracted), reExtracted)
+	}
+	if reExtracted[0].Assignee != "alice" {
+		t.Errorf("Assignee after rewrite = %q, want %q", reExtracted[0].Assignee, "alice")
+	}
+	if reExtracted[0].TicketID != results[0].TicketID {
+		t.Errorf("TicketID after rewrite = %q, want %q", reExtracted[0].TicketID, results[0].TicketID)
+	}
+	if reExtracted[0].Message != "add retries" {
+		t.Errorf("Message after rewrite = %q, want %q (no leaked annotation)", reExtracted[0].Message, "add retries")
+	}
+}
+
+// TestSyncCacheHitAfterRewriteFailure exercises the one path where
+// Cache.Get actually matters: CreateIssue succeeds but the subsequent
+// source rewrite fails, so the TODO still looks ticket-less on the next
+// run. The cache must stop that retry from filing a duplicate ticket.
+func TestSyncCacheHitAfterRewriteFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sample.go")
+	// Line 4 has no TODO-style comment, so RewriteTicketID will fail to
+	// find anything to annotate.
+	src := "package sample\n\nfunc F() {\n\tfmt.Println(\"no comment here\")\n}\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tracker := &fakeTracker{}
+	cache, err := OpenCache(filepath.Join(dir, "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todo := TODO{Tag: TagTODO, File: path, Line: 4, Message: "add retries"}
+	opts := SyncOptions{Tracker: tracker, Cache: cache, AutoCreate: true}
+
+	first := Sync(context.Background(), []TODO{todo}, opts)
+	if len(first) != 1 || first[0].Err == nil || first[0].TicketID == "" {
+		t.Fatalf("got %+v, want a created-but-rewrite-failed result", first)
+	}
+	if len(tracker.created) != 1 {
+		t.Fatalf("got %d created tickets, want 1", len(tracker.created))
+	}
+
+	// The comment was never annotated, so the TODO still has no
+	// TicketID on a second pass — this must hit the cache instead of
+	// calling CreateIssue again.
+	second := Sync(context.Background(), []TODO{todo}, opts)
+	if len(second) != 1 || second[0].TicketID != first[0].TicketID {
+		t.Fatalf("got %+v, want the cached ticket %q reused", second, first[0].TicketID)
+	}
+	if len(tracker.created) != 1 {
+		t.Fatalf("expected no duplicate ticket creation, created = %v", tracker.created)
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	tracker := &fakeTracker{}
+	cache, err := OpenCache(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todos := []TODO{{Tag: TagTODO, Message: "add retries"}}
+	results := Sync(context.Background(), todos, SyncOptions{Tracker: tracker, Cache: cache, AutoCreate: true, DryRun: true})
+
+	if len(results) != 1 || results[0].Action != ActionWouldCreate {
+		t.Fatalf("got %+v, want would-create", results)
+	}
+	if len(tracker.created) != 0 {
+		t.Fatalf("dry-run should not create tickets, created = %v", tracker.created)
+	}
+}
@@ -0,0 +1,39 @@
+// Package todotracker extracts TODO/FIXME/HACK/XXX comments from Go source
+// and models them as structured records suitable for reporting and tooling.
+package todotracker
+
+// Tag identifies which of the recognized comment markers a TODO was found
+// under.
+type Tag string
+
+// Recognized comment tags, in the order they are usually prioritized.
+const (
+	TagTODO  Tag = "TODO"
+	TagFIXME Tag = "FIXME"
+	TagHACK  Tag = "HACK"
+	TagXXX   Tag = "XXX"
+)
+
+// TODO is a single extracted comment record. Message is the joined text of
+// every line in the comment group that followed the tag, with the tag,
+// assignee, and ticket prefix stripped.
+type TODO struct {
+	Tag           Tag
+	Assignee      string
+	TicketID      string
+	File          string
+	Line          int
+	EnclosingFunc string
+	Message       string
+	Attachment    Attachment
+}
+
+// Attachment identifies the top-level declaration a TODO sits directly
+// above, with no intervening blank line, so downstream tools can group
+// TODOs by the API surface they refer to. Kind is empty when no such
+// declaration was found.
+type Attachment struct {
+	Kind      string // "func", "type", "var", or "const"
+	Name      string
+	Signature string
+}
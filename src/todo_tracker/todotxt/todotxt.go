@@ -0,0 +1,109 @@
+// Package todotxt implements Gina Trapani's todo.txt format
+// (http://todotxt.org): one task per line, with an optional "(A)"-style
+// priority, an optional creation date, free-text, and inline "+project",
+// "@context", and "key:value" tokens.
+package todotxt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Entry is a single todo.txt task.
+type Entry struct {
+	Priority  string // "A".."Z", or "" for none
+	CreatedAt string // "2006-01-02", or "" for none
+	Text      string
+	Projects  []string
+	Contexts  []string
+	Tags      map[string]string
+}
+
+// String renders e in todo.txt format.
+func (e Entry) String() string {
+	var fields []string
+	if e.Priority != "" {
+		fields = append(fields, "("+e.Priority+")")
+	}
+	if e.CreatedAt != "" {
+		fields = append(fields, e.CreatedAt)
+	}
+	if e.Text != "" {
+		fields = append(fields, e.Text)
+	}
+	for _, p := range e.Projects {
+		fields = append(fields, "+"+p)
+	}
+	for _, c := range e.Contexts {
+		fields = append(fields, "@"+c)
+	}
+
+	keys := make([]string, 0, len(e.Tags))
+	for k := range e.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fields = append(fields, fmt.Sprintf("%s:%s", k, e.Tags[k]))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+var (
+	priorityPattern = regexp.MustCompile(`^\(([A-Z])\)$`)
+	datePattern     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	tagPattern      = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):(\S+)$`)
+)
+
+// Parse reads a todo.txt document and returns one Entry per non-blank
+// line.
+func Parse(data string) ([]Entry, error) {
+	var entries []Entry
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		entries = append(entries, parseLine(line))
+	}
+	return entries, nil
+}
+
+func parseLine(line string) Entry {
+	e := Entry{Tags: map[string]string{}}
+	tokens := strings.Fields(line)
+
+	// The date, if present, only ever sits in the slot right after the
+	// optional priority (index 0, or 1 if a priority is present) — matching
+	// the spec and the order String produces. A date-shaped word anywhere
+	// else in the line is just text.
+	dateIdx := 0
+	if len(tokens) > 0 && priorityPattern.MatchString(tokens[0]) {
+		dateIdx = 1
+	}
+
+	var text []string
+	for i, tok := range tokens {
+		switch {
+		case i == 0 && priorityPattern.MatchString(tok):
+			e.Priority = priorityPattern.FindStringSubmatch(tok)[1]
+		case i == dateIdx && e.CreatedAt == "" && datePattern.MatchString(tok):
+			e.CreatedAt = tok
+		case strings.HasPrefix(tok, "+") && len(tok) > 1:
+			e.Projects = append(e.Projects, tok[1:])
+		case strings.HasPrefix(tok, "@") && len(tok) > 1:
+			e.Contexts = append(e.Contexts, tok[1:])
+		case tagPattern.MatchString(tok):
+			m := tagPattern.FindStringSubmatch(tok)
+			e.Tags[m[1]] = m[2]
+		default:
+			text = append(text, tok)
+		}
+	}
+
+	e.Text = strings.Join(text, " ")
+	return e
+}
@@ -0,0 +1,66 @@
+package todotxt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	entries := []Entry{
+		{
+			Priority:  "A",
+			CreatedAt: "2026-07-29",
+			Text:      "bypass rate limiter for internal calls",
+			Projects:  []string{"main"},
+			Contexts:  []string{"main"},
+			Tags:      map[string]string{"file": "sample.go", "line": "13", "ticket": "PROJ-567"},
+		},
+		{
+			Priority: "B",
+			Text:     "add CLI argument parsing",
+			Projects: []string{"main"},
+			Contexts: []string{"main"},
+			Tags:     map[string]string{"file": "sample.go", "line": "7"},
+		},
+	}
+
+	var rendered string
+	for _, e := range entries {
+		rendered += e.String() + "\n"
+	}
+
+	got, err := Parse(rendered)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(got, entries) {
+		t.Fatalf("round trip mismatch:\n got:  %+v\n want: %+v", got, entries)
+	}
+}
+
+func TestRoundTripDateShapedWordInText(t *testing.T) {
+	entry := Entry{
+		Priority: "B",
+		Text:     "migrate before 2024-01-01 deadline",
+		Projects: []string{"infra"},
+		Tags:     map[string]string{},
+	}
+
+	got, err := Parse(entry.String() + "\n")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0], entry) {
+		t.Fatalf("round trip mismatch:\n got:  %+v\n want: %+v", got, []Entry{entry})
+	}
+}
+
+func TestParseIgnoresBlankLines(t *testing.T) {
+	entries, err := Parse("(A) 2026-07-29 fix the thing +proj @ctx file:a.go\n\n\n")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+}
@@ -0,0 +1,66 @@
+package todotracker
+
+import (
+	"go/parser"
+	"go/token"
+	"strconv"
+
+	"github.com/JoshGearou/dev-toolkit/src/todo_tracker/todotxt"
+)
+
+// todotxtPriority maps a Tag to the todo.txt priority convention used by
+// ExportTodoTxt: FIXME is the most urgent (A), TODO is routine (B), and
+// HACK/XXX are cleanup-flavored (C).
+func todotxtPriority(tag Tag) string {
+	switch tag {
+	case TagFIXME:
+		return "A"
+	case TagTODO:
+		return "B"
+	default:
+		return "C"
+	}
+}
+
+// packageName returns the package clause of a Go source file.
+func packageName(path string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	return file.Name.Name, nil
+}
+
+// ExportTodoTxt converts todos to todo.txt entries: priority from tag,
+// creation date from git blame on the comment line, +project from the Go
+// package name, @context from the enclosing function, and file/line/ticket
+// metadata. A TODO whose creation date or package name can't be
+// determined (e.g. the file isn't committed yet) simply omits that field.
+func ExportTodoTxt(todos []TODO) []todotxt.Entry {
+	entries := make([]todotxt.Entry, 0, len(todos))
+	for _, t := range todos {
+		entry := todotxt.Entry{
+			Priority: todotxtPriority(t.Tag),
+			Text:     t.Message,
+			Tags: map[string]string{
+				"file": t.File,
+				"line": strconv.Itoa(t.Line),
+			},
+		}
+		if t.TicketID != "" {
+			entry.Tags["ticket"] = t.TicketID
+		}
+		if pkg, err := packageName(t.File); err == nil {
+			entry.Projects = []string{pkg}
+		}
+		if t.EnclosingFunc != "" {
+			entry.Contexts = []string{t.EnclosingFunc}
+		}
+		if date, err := blameCreationDate(t.File, t.Line); err == nil {
+			entry.CreatedAt = date.Format("2006-01-02")
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,31 @@
+package todotracker
+
+import "testing"
+
+func TestExportTodoTxt(t *testing.T) {
+	todos := sampleTODOs(t)
+	entries := ExportTodoTxt(todos)
+
+	if len(entries) != len(todos) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(todos))
+	}
+
+	hack := entries[2]
+	if hack.Priority != "C" {
+		t.Errorf("HACK priority = %q, want C", hack.Priority)
+	}
+	if hack.Tags["ticket"] != "PROJ-567" {
+		t.Errorf("HACK ticket tag = %q, want PROJ-567", hack.Tags["ticket"])
+	}
+	if len(hack.Contexts) != 1 || hack.Contexts[0] != "main" {
+		t.Errorf("HACK context = %v, want [main]", hack.Contexts)
+	}
+	if len(hack.Projects) != 1 || hack.Projects[0] != "main" {
+		t.Errorf("HACK project = %v, want [main]", hack.Projects)
+	}
+
+	fixme := entries[1]
+	if fixme.Priority != "A" {
+		t.Errorf("FIXME priority = %q, want A", fixme.Priority)
+	}
+}
@@ -0,0 +1,228 @@
+package todotracker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Tracker reconciles TODO ticket references against an external issue
+// tracker: checking whether a referenced ticket still exists and is open,
+// and optionally filing a new one.
+type Tracker interface {
+	// IssueOpen reports whether the ticket identified by id exists and is
+	// still open.
+	IssueOpen(ctx context.Context, id string) (bool, error)
+
+	// CreateIssue files a new ticket with the given title and body and
+	// returns its ID.
+	CreateIssue(ctx context.Context, title, body string) (id string, err error)
+}
+
+// httpDo is the subset of *http.Client used by the tracker
+// implementations, so tests can substitute a fake.
+type httpDo interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// GitHubTracker reconciles tickets against GitHub Issues using a token
+// read from the GITHUB_TOKEN environment variable.
+type GitHubTracker struct {
+	Owner, Repo string
+	Token       string
+	client      httpDo
+}
+
+// NewGitHubTracker returns a Tracker backed by the GitHub Issues REST API
+// for owner/repo, authenticating with GITHUB_TOKEN.
+func NewGitHubTracker(owner, repo string) (*GitHubTracker, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("todotracker: GITHUB_TOKEN is not set")
+	}
+	return &GitHubTracker{Owner: owner, Repo: repo, Token: token, client: http.DefaultClient}, nil
+}
+
+func (t *GitHubTracker) IssueOpen(ctx context.Context, id string) (bool, error) {
+	number := strings.TrimPrefix(id, "GH-")
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/issues/%s", t.Owner, t.Repo, number)
+	var issue struct {
+		State string `json:"state"`
+	}
+	if err := t.doJSON(ctx, http.MethodGet, url, nil, &issue); err != nil {
+		return false, err
+	}
+	return issue.State == "open", nil
+}
+
+func (t *GitHubTracker) CreateIssue(ctx context.Context, title, body string) (string, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/issues", t.Owner, t.Repo)
+	payload := map[string]string{"title": title, "body": body}
+	var created struct {
+		Number int `json:"number"`
+	}
+	if err := t.doJSON(ctx, http.MethodPost, url, payload, &created); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("GH-%d", created.Number), nil
+}
+
+func (t *GitHubTracker) doJSON(ctx context.Context, method, url string, body, out any) error {
+	return doJSON(ctx, t.client, method, url, "token "+t.Token, body, out)
+}
+
+// GitLabTracker reconciles tickets against a GitLab project's issues,
+// authenticating with GITLAB_TOKEN. BaseURL defaults to https://gitlab.com.
+type GitLabTracker struct {
+	BaseURL   string
+	ProjectID string
+	Token     string
+	client    httpDo
+}
+
+// NewGitLabTracker returns a Tracker backed by the GitLab Issues REST API
+// for projectID, authenticating with GITLAB_TOKEN. The API base URL may be
+// overridden with GITLAB_BASE_URL for self-hosted instances.
+func NewGitLabTracker(projectID string) (*GitLabTracker, error) {
+	token := os.Getenv("GITLAB_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("todotracker: GITLAB_TOKEN is not set")
+	}
+	baseURL := os.Getenv("GITLAB_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://gitlab.com"
+	}
+	return &GitLabTracker{BaseURL: baseURL, ProjectID: projectID, Token: token, client: http.DefaultClient}, nil
+}
+
+func (t *GitLabTracker) IssueOpen(ctx context.Context, id string) (bool, error) {
+	iid := strings.TrimPrefix(id, "GL-")
+	url := fmt.Sprintf("%s/api/v4/projects/%s/issues/%s", t.BaseURL, t.ProjectID, iid)
+	var issue struct {
+		State string `json:"state"`
+	}
+	if err := t.doJSON(ctx, http.MethodGet, url, nil, &issue); err != nil {
+		return false, err
+	}
+	return issue.State == "opened", nil
+}
+
+func (t *GitLabTracker) CreateIssue(ctx context.Context, title, body string) (string, error) {
+	url := fmt.Sprintf("%s/api/v4/projects/%s/issues", t.BaseURL, t.ProjectID)
+	payload := map[string]string{"title": title, "description": body}
+	var created struct {
+		IID int `json:"iid"`
+	}
+	if err := t.doJSON(ctx, http.MethodPost, url, payload, &created); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("GL-%d", created.IID), nil
+}
+
+func (t *GitLabTracker) doJSON(ctx context.Context, method, url string, body, out any) error {
+	return doJSON(ctx, t.client, method, url, "Bearer "+t.Token, body, out)
+}
+
+// JiraTracker reconciles tickets against a Jira project's issues,
+// authenticating with basic auth built from JIRA_EMAIL and JIRA_API_TOKEN.
+type JiraTracker struct {
+	BaseURL    string
+	ProjectKey string
+	Email      string
+	APIToken   string
+	client     httpDo
+}
+
+// NewJiraTracker returns a Tracker backed by the Jira REST API for
+// projectKey, reading JIRA_BASE_URL, JIRA_EMAIL, and JIRA_API_TOKEN.
+func NewJiraTracker(projectKey string) (*JiraTracker, error) {
+	baseURL := os.Getenv("JIRA_BASE_URL")
+	email := os.Getenv("JIRA_EMAIL")
+	token := os.Getenv("JIRA_API_TOKEN")
+	if baseURL == "" || email == "" || token == "" {
+		return nil, fmt.Errorf("todotracker: JIRA_BASE_URL, JIRA_EMAIL, and JIRA_API_TOKEN must all be set")
+	}
+	return &JiraTracker{BaseURL: baseURL, ProjectKey: projectKey, Email: email, APIToken: token, client: http.DefaultClient}, nil
+}
+
+func (t *JiraTracker) IssueOpen(ctx context.Context, id string) (bool, error) {
+	url := fmt.Sprintf("%s/rest/api/2/issue/%s", t.BaseURL, id)
+	var issue struct {
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := t.doJSON(ctx, http.MethodGet, url, nil, &issue); err != nil {
+		return false, err
+	}
+	return !strings.EqualFold(issue.Fields.Status.Name, "Done"), nil
+}
+
+func (t *JiraTracker) CreateIssue(ctx context.Context, title, body string) (string, error) {
+	url := fmt.Sprintf("%s/rest/api/2/issue", t.BaseURL)
+	payload := map[string]any{
+		"fields": map[string]any{
+			"project":     map[string]string{"key": t.ProjectKey},
+			"summary":     title,
+			"description": body,
+			"issuetype":   map[string]string{"name": "Task"},
+		},
+	}
+	var created struct {
+		Key string `json:"key"`
+	}
+	if err := t.doJSON(ctx, http.MethodPost, url, payload, &created); err != nil {
+		return "", err
+	}
+	return created.Key, nil
+}
+
+func (t *JiraTracker) doJSON(ctx context.Context, method, url string, body, out any) error {
+	auth := base64.StdEncoding.EncodeToString([]byte(t.Email + ":" + t.APIToken))
+	return doJSON(ctx, t.client, method, url, "Basic "+auth, body, out)
+}
+
+// doJSON performs an HTTP request with an optional JSON body, an
+// Authorization header, and decodes a JSON response into out.
+func doJSON(ctx context.Context, client httpDo, method, url, auth string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = strings.NewReader(string(encoded))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("todotracker: %s %s: %s: %s", method, url, resp.Status, string(data))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
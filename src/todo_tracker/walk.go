@@ -0,0 +1,142 @@
+package todotracker
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// WalkOptions controls directory-walking extraction.
+type WalkOptions struct {
+	Options
+
+	// IncludeVendor and IncludeTestdata, when false (the default), skip
+	// any directory named "vendor" or "testdata".
+	IncludeVendor   bool
+	IncludeTestdata bool
+
+	// Progress, if non-nil, receives a running "scanned N/M files"
+	// indicator as files are processed.
+	Progress io.Writer
+}
+
+// skipDir reports whether a directory should be excluded from the walk by
+// default.
+func (o WalkOptions) skipDir(name string) bool {
+	if !o.IncludeVendor && name == "vendor" {
+		return true
+	}
+	if !o.IncludeTestdata && name == "testdata" {
+		return true
+	}
+	return name != "." && strings.HasPrefix(name, ".")
+}
+
+// Walk recursively discovers .go files under root, honoring .gitignore and
+// the default vendor/testdata skips, and parses them concurrently using a
+// worker pool sized to GOMAXPROCS. Extracted TODOs stream through the
+// returned channel as files finish; per-file errors stream through the
+// second channel. Both channels are closed once the walk completes.
+func Walk(root string, opts WalkOptions) (<-chan TODO, <-chan error) {
+	todos := make(chan TODO)
+	errs := make(chan error)
+
+	go func() {
+		defer close(todos)
+		defer close(errs)
+
+		paths, err := discoverGoFiles(root, opts)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		total := len(paths)
+		var scanned int64
+		pathCh := make(chan string)
+
+		var wg sync.WaitGroup
+		workers := runtime.GOMAXPROCS(0)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for path := range pathCh {
+					fileTodos, err := ExtractFile(path, opts.Options)
+					if err != nil {
+						errs <- err
+					}
+					for _, t := range fileTodos {
+						todos <- t
+					}
+					if opts.Progress != nil {
+						n := atomic.AddInt64(&scanned, 1)
+						fmt.Fprintf(opts.Progress, "\rscanned %d/%d files", n, total)
+					}
+				}
+			}()
+		}
+
+		for _, p := range paths {
+			pathCh <- p
+		}
+		close(pathCh)
+		wg.Wait()
+
+		if opts.Progress != nil {
+			fmt.Fprintln(opts.Progress)
+		}
+	}()
+
+	return todos, errs
+}
+
+// discoverGoFiles walks root and returns every non-ignored .go file path.
+func discoverGoFiles(root string, opts WalkOptions) ([]string, error) {
+	ignore, err := loadGitignore(root)
+	if err != nil {
+		return nil, fmt.Errorf("todotracker: loading .gitignore under %s: %w", root, err)
+	}
+
+	var paths []string
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil {
+			return relErr
+		}
+
+		if d.IsDir() {
+			if path != root && opts.skipDir(d.Name()) {
+				return filepath.SkipDir
+			}
+			if ignore.match(rel, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+		if ignore.match(rel, false) {
+			return nil
+		}
+		if opts.excludes(path) {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
@@ -0,0 +1,99 @@
+package todotracker
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "a.go"), "package a\n\n// TODO: wire up a\nfunc A() {}\n")
+	writeFile(t, filepath.Join(root, "sub", "b.go"), "package sub\n\n// FIXME: wire up b\nfunc B() {}\n")
+	writeFile(t, filepath.Join(root, "vendor", "v.go"), "package vendor\n\n// TODO: should be skipped\nfunc V() {}\n")
+	writeFile(t, filepath.Join(root, "testdata", "t.go"), "package testdata\n\n// TODO: should be skipped\nfunc T() {}\n")
+	writeFile(t, filepath.Join(root, "ignored", "i.go"), "package ignored\n\n// TODO: should be skipped\nfunc I() {}\n")
+	writeFile(t, filepath.Join(root, ".gitignore"), "ignored/\n")
+
+	todoCh, errCh := Walk(root, WalkOptions{})
+
+	var got []TODO
+	var errs []error
+	for todoCh != nil || errCh != nil {
+		select {
+		case t, ok := <-todoCh:
+			if !ok {
+				todoCh = nil
+				continue
+			}
+			got = append(got, t)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d todos, want 2: %+v", len(got), got)
+	}
+
+	messages := []string{got[0].Message, got[1].Message}
+	sort.Strings(messages)
+	if messages[0] != "wire up a" || messages[1] != "wire up b" {
+		t.Fatalf("unexpected messages: %v", messages)
+	}
+}
+
+func TestWalkRootGitignoreDotfile(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "a.go"), "package a\n\n// TODO: wire up a\nfunc A() {}\n")
+	writeFile(t, filepath.Join(root, ".hidden.go"), "package hidden\n\n// TODO: should be skipped\nfunc H() {}\n")
+	writeFile(t, filepath.Join(root, ".gitignore"), ".hidden.go\n")
+
+	todoCh, errCh := Walk(root, WalkOptions{})
+
+	var got []TODO
+	var errs []error
+	for todoCh != nil || errCh != nil {
+		select {
+		case t, ok := <-todoCh:
+			if !ok {
+				todoCh = nil
+				continue
+			}
+			got = append(got, t)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(got) != 1 || got[0].Message != "wire up a" {
+		t.Fatalf("got %+v, want only the TODO from a.go (root .gitignore should skip .hidden.go)", got)
+	}
+}